@@ -15,7 +15,7 @@ type MemoryResponse struct {
 	Query            string            `json:"query"`
 	Context          string            `json:"context"`
 	Metadata         map[string]string `json:"metadata"`
-	Timestamp        int64             `json:"timestamp"`
+	Timestamp        string            `json:"timestamp"` // RFC3339, UTC
 	ProcessingTimeMs int64             `json:"processing_time_ms"`
 }
 
@@ -43,8 +43,8 @@ func main() {
 			"memory_system":   "ltst",
 			"processing_mode": "simulated",
 		},
-		Timestamp:        time.Now().Unix(),
-		ProcessingTimeMs: time.Since(startTime).Milliseconds(),
+		Timestamp:        time.Now().UTC().Format(time.RFC3339),
+		ProcessingTimeMs: time.Since(startTime).Milliseconds(), // monotonic delta
 	}
 
 	// Output JSON response